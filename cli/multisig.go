@@ -95,6 +95,11 @@ var msigCreateCmd = &cli.Command{
 			return ShowHelp(cctx, fmt.Errorf("multisigs must have at least one signer"))
 		}
 
+		confidence, err := ParseConfidence(cctx)
+		if err != nil {
+			return err
+		}
+
 		api, closer, err := GetFullNodeAPI(cctx)
 		if err != nil {
 			return err
@@ -152,7 +157,7 @@ var msigCreateCmd = &cli.Command{
 		}
 
 		// wait for it to get mined into a block
-		wait, err := api.StateWaitMsg(ctx, msgCid, uint64(cctx.Int("confidence")))
+		wait, err := api.StateWaitMsg(ctx, msgCid, confidence)
 		if err != nil {
 			return err
 		}
@@ -364,6 +369,11 @@ var msigProposeCmd = &cli.Command{
 			return ShowHelp(cctx, fmt.Errorf("must either pass three or five arguments"))
 		}
 
+		confidence, err := ParseConfidence(cctx)
+		if err != nil {
+			return err
+		}
+
 		api, closer, err := GetFullNodeAPI(cctx)
 		if err != nil {
 			return err
@@ -433,7 +443,7 @@ var msigProposeCmd = &cli.Command{
 
 		fmt.Println("send proposal in message: ", msgCid)
 
-		wait, err := api.StateWaitMsg(ctx, msgCid, uint64(cctx.Int("confidence")))
+		wait, err := api.StateWaitMsg(ctx, msgCid, confidence)
 		if err != nil {
 			return err
 		}
@@ -481,6 +491,11 @@ var msigApproveCmd = &cli.Command{
 			return ShowHelp(cctx, fmt.Errorf("usage: msig approve <msig addr> <message ID> <proposer address> <desination> <value> [ <method> <params> ]"))
 		}
 
+		confidence, err := ParseConfidence(cctx)
+		if err != nil {
+			return err
+		}
+
 		api, closer, err := GetFullNodeAPI(cctx)
 		if err != nil {
 			return err
@@ -566,7 +581,7 @@ var msigApproveCmd = &cli.Command{
 
 		fmt.Println("sent approval in message: ", msgCid)
 
-		wait, err := api.StateWaitMsg(ctx, msgCid, uint64(cctx.Int("confidence")))
+		wait, err := api.StateWaitMsg(ctx, msgCid, confidence)
 		if err != nil {
 			return err
 		}
@@ -598,6 +613,11 @@ var msigRemoveProposeCmd = &cli.Command{
 			return ShowHelp(cctx, fmt.Errorf("must pass multisig address and signer address"))
 		}
 
+		confidence, err := ParseConfidence(cctx)
+		if err != nil {
+			return err
+		}
+
 		api, closer, err := GetFullNodeAPI(cctx)
 		if err != nil {
 			return err
@@ -637,7 +657,7 @@ var msigRemoveProposeCmd = &cli.Command{
 
 		fmt.Println("sent remove proposal in message: ", msgCid)
 
-		wait, err := api.StateWaitMsg(ctx, msgCid, uint64(cctx.Int("confidence")))
+		wait, err := api.StateWaitMsg(ctx, msgCid, confidence)
 		if err != nil {
 			return err
 		}
@@ -676,6 +696,11 @@ var msigAddProposeCmd = &cli.Command{
 			return ShowHelp(cctx, fmt.Errorf("must pass multisig address and signer address"))
 		}
 
+		confidence, err := ParseConfidence(cctx)
+		if err != nil {
+			return err
+		}
+
 		api, closer, err := GetFullNodeAPI(cctx)
 		if err != nil {
 			return err
@@ -715,7 +740,7 @@ var msigAddProposeCmd = &cli.Command{
 
 		fmt.Fprintln(cctx.App.Writer, "sent add proposal in message: ", msgCid)
 
-		wait, err := api.StateWaitMsg(ctx, msgCid, uint64(cctx.Int("confidence")))
+		wait, err := api.StateWaitMsg(ctx, msgCid, confidence)
 		if err != nil {
 			return err
 		}
@@ -743,6 +768,11 @@ var msigAddApproveCmd = &cli.Command{
 			return ShowHelp(cctx, fmt.Errorf("must pass multisig address, proposer address, transaction id, new signer address, whether to increase threshold"))
 		}
 
+		confidence, err := ParseConfidence(cctx)
+		if err != nil {
+			return err
+		}
+
 		api, closer, err := GetFullNodeAPI(cctx)
 		if err != nil {
 			return err
@@ -797,7 +827,7 @@ var msigAddApproveCmd = &cli.Command{
 
 		fmt.Println("sent add approval in message: ", msgCid)
 
-		wait, err := api.StateWaitMsg(ctx, msgCid, uint64(cctx.Int("confidence")))
+		wait, err := api.StateWaitMsg(ctx, msgCid, confidence)
 		if err != nil {
 			return err
 		}
@@ -825,6 +855,11 @@ var msigAddCancelCmd = &cli.Command{
 			return ShowHelp(cctx, fmt.Errorf("must pass multisig address, transaction id, new signer address, whether to increase threshold"))
 		}
 
+		confidence, err := ParseConfidence(cctx)
+		if err != nil {
+			return err
+		}
+
 		api, closer, err := GetFullNodeAPI(cctx)
 		if err != nil {
 			return err
@@ -874,7 +909,7 @@ var msigAddCancelCmd = &cli.Command{
 
 		fmt.Println("sent add cancellation in message: ", msgCid)
 
-		wait, err := api.StateWaitMsg(ctx, msgCid, uint64(cctx.Int("confidence")))
+		wait, err := api.StateWaitMsg(ctx, msgCid, confidence)
 		if err != nil {
 			return err
 		}
@@ -902,6 +937,11 @@ var msigSwapProposeCmd = &cli.Command{
 			return ShowHelp(cctx, fmt.Errorf("must pass multisig address, old signer address, new signer address"))
 		}
 
+		confidence, err := ParseConfidence(cctx)
+		if err != nil {
+			return err
+		}
+
 		api, closer, err := GetFullNodeAPI(cctx)
 		if err != nil {
 			return err
@@ -946,7 +986,7 @@ var msigSwapProposeCmd = &cli.Command{
 
 		fmt.Println("sent swap proposal in message: ", msgCid)
 
-		wait, err := api.StateWaitMsg(ctx, msgCid, uint64(cctx.Int("confidence")))
+		wait, err := api.StateWaitMsg(ctx, msgCid, confidence)
 		if err != nil {
 			return err
 		}
@@ -974,6 +1014,11 @@ var msigSwapApproveCmd = &cli.Command{
 			return ShowHelp(cctx, fmt.Errorf("must pass multisig address, proposer address, transaction id, old signer address, new signer address"))
 		}
 
+		confidence, err := ParseConfidence(cctx)
+		if err != nil {
+			return err
+		}
+
 		api, closer, err := GetFullNodeAPI(cctx)
 		if err != nil {
 			return err
@@ -1028,7 +1073,7 @@ var msigSwapApproveCmd = &cli.Command{
 
 		fmt.Println("sent swap approval in message: ", msgCid)
 
-		wait, err := api.StateWaitMsg(ctx, msgCid, uint64(cctx.Int("confidence")))
+		wait, err := api.StateWaitMsg(ctx, msgCid, confidence)
 		if err != nil {
 			return err
 		}
@@ -1056,6 +1101,11 @@ var msigSwapCancelCmd = &cli.Command{
 			return ShowHelp(cctx, fmt.Errorf("must pass multisig address, transaction id, old signer address, new signer address"))
 		}
 
+		confidence, err := ParseConfidence(cctx)
+		if err != nil {
+			return err
+		}
+
 		api, closer, err := GetFullNodeAPI(cctx)
 		if err != nil {
 			return err
@@ -1105,7 +1155,7 @@ var msigSwapCancelCmd = &cli.Command{
 
 		fmt.Println("sent swap cancellation in message: ", msgCid)
 
-		wait, err := api.StateWaitMsg(ctx, msgCid, uint64(cctx.Int("confidence")))
+		wait, err := api.StateWaitMsg(ctx, msgCid, confidence)
 		if err != nil {
 			return err
 		}
@@ -1133,6 +1183,11 @@ var msigLockProposeCmd = &cli.Command{
 			return ShowHelp(cctx, fmt.Errorf("must pass multisig address, start epoch, unlock duration, and amount"))
 		}
 
+		confidence, err := ParseConfidence(cctx)
+		if err != nil {
+			return err
+		}
+
 		api, closer, err := GetFullNodeAPI(cctx)
 		if err != nil {
 			return err
@@ -1192,7 +1247,7 @@ var msigLockProposeCmd = &cli.Command{
 
 		fmt.Println("sent lock proposal in message: ", msgCid)
 
-		wait, err := api.StateWaitMsg(ctx, msgCid, uint64(cctx.Int("confidence")))
+		wait, err := api.StateWaitMsg(ctx, msgCid, confidence)
 		if err != nil {
 			return err
 		}
@@ -1220,6 +1275,11 @@ var msigLockApproveCmd = &cli.Command{
 			return ShowHelp(cctx, fmt.Errorf("must pass multisig address, proposer address, tx id, start epoch, unlock duration, and amount"))
 		}
 
+		confidence, err := ParseConfidence(cctx)
+		if err != nil {
+			return err
+		}
+
 		api, closer, err := GetFullNodeAPI(cctx)
 		if err != nil {
 			return err
@@ -1289,7 +1349,7 @@ var msigLockApproveCmd = &cli.Command{
 
 		fmt.Println("sent lock approval in message: ", msgCid)
 
-		wait, err := api.StateWaitMsg(ctx, msgCid, uint64(cctx.Int("confidence")))
+		wait, err := api.StateWaitMsg(ctx, msgCid, confidence)
 		if err != nil {
 			return err
 		}
@@ -1317,6 +1377,11 @@ var msigLockCancelCmd = &cli.Command{
 			return ShowHelp(cctx, fmt.Errorf("must pass multisig address, tx id, start epoch, unlock duration, and amount"))
 		}
 
+		confidence, err := ParseConfidence(cctx)
+		if err != nil {
+			return err
+		}
+
 		api, closer, err := GetFullNodeAPI(cctx)
 		if err != nil {
 			return err
@@ -1381,7 +1446,7 @@ var msigLockCancelCmd = &cli.Command{
 
 		fmt.Println("sent lock cancellation in message: ", msgCid)
 
-		wait, err := api.StateWaitMsg(ctx, msgCid, uint64(cctx.Int("confidence")))
+		wait, err := api.StateWaitMsg(ctx, msgCid, confidence)
 		if err != nil {
 			return err
 		}
@@ -1471,6 +1536,11 @@ var msigProposeThresholdCmd = &cli.Command{
 			return ShowHelp(cctx, fmt.Errorf("must pass multisig address and new threshold value"))
 		}
 
+		confidence, err := ParseConfidence(cctx)
+		if err != nil {
+			return err
+		}
+
 		api, closer, err := GetFullNodeAPI(cctx)
 		if err != nil {
 			return err
@@ -1518,7 +1588,7 @@ var msigProposeThresholdCmd = &cli.Command{
 
 		fmt.Println("sent change threshold proposal in message: ", msgCid)
 
-		wait, err := api.StateWaitMsg(ctx, msgCid, uint64(cctx.Int("confidence")))
+		wait, err := api.StateWaitMsg(ctx, msgCid, confidence)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/hako/durafmt"
 	"github.com/ipfs/go-cid"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/xerrors"
 
 	"github.com/filecoin-project/go-state-types/abi"
 
@@ -46,3 +48,17 @@ func EpochTime(curr, e abi.ChainEpoch) string {
 
 	panic("math broke")
 }
+
+// ParseConfidence reads the --confidence flag shared by the commands that
+// wait for a message to land on chain. Negative values are rejected, since
+// they would wrap around when passed to StateWaitMsg.
+func ParseConfidence(cctx *cli.Context) (uint64, error) {
+	confidence := cctx.Int("confidence")
+	if confidence < 0 {
+		return 0, xerrors.Errorf("confidence must not be negative, got %d", confidence)
+	}
+	if abi.ChainEpoch(confidence) > build.Finality {
+		log.Warnf("waiting for %d confirmations, which is more than finality (%d epochs)", confidence, build.Finality)
+	}
+	return uint64(confidence), nil
+}
@@ -0,0 +1,25 @@
+package cli
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+func TestParseConfidence(t *testing.T) {
+	run := func(val string) (uint64, error) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.Int("confidence", 0, "")
+		require.NoError(t, fs.Set("confidence", val))
+		return ParseConfidence(cli.NewContext(cli.NewApp(), fs, nil))
+	}
+
+	conf, err := run("5")
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), conf)
+
+	_, err = run("-1")
+	require.Error(t, err)
+}
@@ -13,6 +13,7 @@ import (
 
 	miner2 "github.com/filecoin-project/specs-actors/v2/actors/builtin/miner"
 
+	"github.com/filecoin-project/lotus/build"
 	"github.com/filecoin-project/lotus/chain/actors"
 	"github.com/filecoin-project/lotus/chain/actors/builtin/miner"
 	"github.com/filecoin-project/lotus/chain/types"
@@ -38,6 +39,11 @@ var terminateSectorCmd = &cli.Command{
 			Name:  "really-do-it",
 			Usage: "pass this flag if you know what you are doing",
 		},
+		&cli.IntFlag{
+			Name:  "confidence",
+			Usage: "number of block confirmations to wait for",
+			Value: int(build.MessageConfidence),
+		},
 	},
 	Action: func(cctx *cli.Context) error {
 		if cctx.Args().Len() < 1 {
@@ -48,6 +54,11 @@ var terminateSectorCmd = &cli.Command{
 			return fmt.Errorf("this is a command for advanced users, only use it if you are sure of what you are doing")
 		}
 
+		confidence, err := lcli.ParseConfidence(cctx)
+		if err != nil {
+			return err
+		}
+
 		nodeApi, closer, err := lcli.GetFullNodeAPI(cctx)
 		if err != nil {
 			return err
@@ -120,7 +131,7 @@ var terminateSectorCmd = &cli.Command{
 
 		fmt.Println("sent termination message:", smsg.Cid())
 
-		wait, err := nodeApi.StateWaitMsg(ctx, smsg.Cid(), uint64(cctx.Int("confidence")))
+		wait, err := nodeApi.StateWaitMsg(ctx, smsg.Cid(), confidence)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,51 @@
+package badgerbs
+
+import (
+	"fmt"
+	"testing"
+
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/lotus/lib/blockstore"
+)
+
+// BenchmarkLRUCacheStore compares sequential reads straight from badger with
+// reads through an LRU cache large enough to hold the whole working set.
+func BenchmarkLRUCacheStore(b *testing.B) {
+	bs, _ := newBlockstore(DefaultOptions)(b)
+	defer bs.(*Blockstore).Close() //nolint:errcheck
+
+	var keys []cid.Cid
+	for i := 0; i < 1024; i++ {
+		data := make([]byte, 1024)
+		copy(data, fmt.Sprintf("block %d", i))
+		blk := blocks.NewBlock(data)
+		require.NoError(b, bs.Put(blk))
+		keys = append(keys, blk.Cid())
+	}
+
+	read := func(bs blockstore.Blockstore) func(b *testing.B) {
+		return func(b *testing.B) {
+			// warm up, so the cached run measures hits rather than the
+			// initial fill
+			for _, k := range keys {
+				if _, err := bs.Get(k); err != nil {
+					b.Fatal(err)
+				}
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := bs.Get(keys[i%len(keys)]); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	}
+
+	b.Run("uncached", read(bs))
+	b.Run("cached", read(blockstore.NewLRUCacheStore(bs, 2<<20)))
+}
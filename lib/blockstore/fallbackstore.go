@@ -11,22 +11,46 @@ import (
 	"github.com/ipfs/go-cid"
 	blockstore "github.com/ipfs/go-ipfs-blockstore"
 	logging "github.com/ipfs/go-log"
+	"go.opencensus.io/stats"
 )
 
 var log = logging.Logger("blockstore")
 
+// FallbackGetBlock fetches a block from somewhere other than the local store.
+type FallbackGetBlock func(context.Context, cid.Cid) (blocks.Block, error)
+
 type FallbackStore struct {
 	blockstore.Blockstore
 
-	fallbackGetBlock func(context.Context, cid.Cid) (blocks.Block, error)
-	lk               sync.RWMutex
+	fallbacks []FallbackGetBlock
+	lk        sync.RWMutex
 }
 
+// SetFallback configures a single fallback, replacing any previously set.
+// A nil fg clears the fallbacks.
 func (fbs *FallbackStore) SetFallback(fg func(context.Context, cid.Cid) (blocks.Block, error)) {
+	var fgs []FallbackGetBlock
+	if fg != nil {
+		fgs = append(fgs, fg)
+	}
+	_ = fbs.SetFallbacks(fgs)
+}
+
+// SetFallbacks configures an ordered list of fallbacks, replacing any
+// previously set. On a local miss, each fallback is tried in turn until one
+// of them returns the block. Nil entries are rejected.
+func (fbs *FallbackStore) SetFallbacks(fgs []FallbackGetBlock) error {
+	for i, fg := range fgs {
+		if fg == nil {
+			return xerrors.Errorf("fallback %d is nil", i)
+		}
+	}
+
 	fbs.lk.Lock()
 	defer fbs.lk.Unlock()
 
-	fbs.fallbackGetBlock = fg
+	fbs.fallbacks = append([]FallbackGetBlock(nil), fgs...)
+	return nil
 }
 
 func (fbs *FallbackStore) getFallback(c cid.Cid) (blocks.Block, error) {
@@ -34,23 +58,37 @@ func (fbs *FallbackStore) getFallback(c cid.Cid) (blocks.Block, error) {
 	fbs.lk.RLock()
 	defer fbs.lk.RUnlock()
 
-	if fbs.fallbackGetBlock == nil {
+	if len(fbs.fallbacks) == 0 {
 		// FallbackStore wasn't configured yet (chainstore/bitswap aren't up yet)
 		// Wait for a bit and retry
 		fbs.lk.RUnlock()
 		time.Sleep(5 * time.Second)
 		fbs.lk.RLock()
 
-		if fbs.fallbackGetBlock == nil {
+		if len(fbs.fallbacks) == 0 {
 			log.Errorw("fallbackstore: fallbackGetBlock not configured yet")
+			stats.Record(context.TODO(), FallbackStoreFallbackMiss.M(1))
 			return nil, blockstore.ErrNotFound
 		}
 	}
 
-	ctx, cancel := context.WithTimeout(context.TODO(), 120*time.Second)
-	defer cancel()
-
-	b, err := fbs.fallbackGetBlock(ctx, c)
+	start := time.Now()
+	var (
+		b   blocks.Block
+		err error
+	)
+	for i, fg := range fbs.fallbacks {
+		b, err = fetchFallback(fg, c)
+		if err == nil {
+			break
+		}
+		// only the last error is returned, so don't let earlier
+		// timeouts or transport errors go unnoticed
+		if i < len(fbs.fallbacks)-1 && !xerrors.Is(err, blockstore.ErrNotFound) {
+			log.Warnw("fallbackstore: fallback failed, trying next", "cid", c, "index", i, "error", err)
+		}
+	}
+	recordFallback(start, err)
 	if err != nil {
 		return nil, err
 	}
@@ -64,6 +102,23 @@ func (fbs *FallbackStore) getFallback(c cid.Cid) (blocks.Block, error) {
 	return b, nil
 }
 
+func recordFallback(start time.Time, err error) {
+	ctx := context.TODO()
+	stats.Record(ctx, FallbackStoreFallbackDuration.M(float64(time.Since(start).Nanoseconds())/1e6))
+	if err != nil {
+		stats.Record(ctx, FallbackStoreFallbackMiss.M(1))
+		return
+	}
+	stats.Record(ctx, FallbackStoreFallbackHit.M(1))
+}
+
+func fetchFallback(fg FallbackGetBlock, c cid.Cid) (blocks.Block, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 120*time.Second)
+	defer cancel()
+
+	return fg(ctx, c)
+}
+
 func (fbs *FallbackStore) Get(c cid.Cid) (blocks.Block, error) {
 	b, err := fbs.Blockstore.Get(c)
 	switch err {
@@ -0,0 +1,134 @@
+package blockstore
+
+import (
+	"context"
+	"testing"
+
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"golang.org/x/xerrors"
+)
+
+func TestFallbackStoreFallbacks(t *testing.T) {
+	blk := blocks.NewBlock([]byte("some data"))
+
+	var calls []int
+	miss := func(i int) FallbackGetBlock {
+		return func(context.Context, cid.Cid) (blocks.Block, error) {
+			calls = append(calls, i)
+			return nil, xerrors.Errorf("fallback %d: %w", i, ErrNotFound)
+		}
+	}
+	hit := func(i int) FallbackGetBlock {
+		return func(context.Context, cid.Cid) (blocks.Block, error) {
+			calls = append(calls, i)
+			return blk, nil
+		}
+	}
+
+	cases := []struct {
+		name      string
+		fallbacks []FallbackGetBlock
+		calls     []int
+		found     bool
+	}{
+		{"first-hit", []FallbackGetBlock{hit(0), miss(1)}, []int{0}, true},
+		{"second-hit", []FallbackGetBlock{miss(0), hit(1)}, []int{0, 1}, true},
+		{"all-miss", []FallbackGetBlock{miss(0), miss(1)}, []int{0, 1}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls = nil
+
+			fbs := &FallbackStore{Blockstore: NewTemporary()}
+			require.NoError(t, fbs.SetFallbacks(tc.fallbacks))
+
+			b, err := fbs.Get(blk.Cid())
+			require.Equal(t, tc.calls, calls)
+
+			has, herr := fbs.Blockstore.Has(blk.Cid())
+			require.NoError(t, herr)
+			require.Equal(t, tc.found, has)
+
+			if !tc.found {
+				require.True(t, xerrors.Is(err, ErrNotFound))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, blk.RawData(), b.RawData())
+		})
+	}
+}
+
+func TestFallbackStoreSetFallback(t *testing.T) {
+	blk := blocks.NewBlock([]byte("some data"))
+
+	fbs := &FallbackStore{Blockstore: NewTemporary()}
+	require.NoError(t, fbs.SetFallbacks([]FallbackGetBlock{func(context.Context, cid.Cid) (blocks.Block, error) {
+		return nil, ErrNotFound
+	}}))
+	fbs.SetFallback(func(context.Context, cid.Cid) (blocks.Block, error) {
+		return blk, nil
+	})
+
+	b, err := fbs.Get(blk.Cid())
+	require.NoError(t, err)
+	require.Equal(t, blk.RawData(), b.RawData())
+}
+
+func TestFallbackStoreRejectsNilFallback(t *testing.T) {
+	fbs := &FallbackStore{Blockstore: NewTemporary()}
+	require.Error(t, fbs.SetFallbacks([]FallbackGetBlock{
+		func(context.Context, cid.Cid) (blocks.Block, error) { return nil, ErrNotFound },
+		nil,
+	}))
+	require.Empty(t, fbs.fallbacks)
+}
+
+func TestFallbackStoreMetrics(t *testing.T) {
+	hitView := &view.View{Name: "test/fallback_hit", Measure: FallbackStoreFallbackHit, Aggregation: view.Count()}
+	missView := &view.View{Name: "test/fallback_miss", Measure: FallbackStoreFallbackMiss, Aggregation: view.Count()}
+	require.NoError(t, view.Register(hitView, missView))
+	defer view.Unregister(hitView, missView)
+
+	count := func(v *view.View) int64 {
+		rows, err := view.RetrieveData(v.Name)
+		require.NoError(t, err)
+		if len(rows) == 0 {
+			return 0
+		}
+		return rows[0].Data.(*view.CountData).Value
+	}
+	counts := func() [2]int64 {
+		return [2]int64{count(hitView), count(missView)}
+	}
+
+	local := blocks.NewBlock([]byte("local data"))
+	remote := blocks.NewBlock([]byte("remote data"))
+	missing := blocks.NewBlock([]byte("missing data"))
+
+	fbs := &FallbackStore{Blockstore: NewTemporary()}
+	require.NoError(t, fbs.Put(local))
+	fbs.SetFallback(func(_ context.Context, c cid.Cid) (blocks.Block, error) {
+		if c == remote.Cid() {
+			return remote, nil
+		}
+		return nil, ErrNotFound
+	})
+
+	// local hits don't touch the fallback counters
+	_, err := fbs.Get(local.Cid())
+	require.NoError(t, err)
+	require.Equal(t, [2]int64{0, 0}, counts())
+
+	_, err = fbs.Get(remote.Cid())
+	require.NoError(t, err)
+	require.Equal(t, [2]int64{1, 0}, counts())
+
+	_, err = fbs.Get(missing.Cid())
+	require.Error(t, err)
+	require.Equal(t, [2]int64{1, 1}, counts())
+}
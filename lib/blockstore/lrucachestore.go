@@ -0,0 +1,196 @@
+package blockstore
+
+import (
+	"container/list"
+	"context"
+	"sync"
+
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+)
+
+// LRUCacheStore is a read-through cache over another blockstore. It keeps
+// the most recently read blocks in memory, bounded by the total size of their
+// data rather than by the number of entries. Writes and deletes go straight
+// to the underlying store; deletes also evict the block from the cache.
+type LRUCacheStore struct {
+	bs Blockstore
+
+	lk       sync.Mutex
+	maxBytes uint64
+	curBytes uint64
+	order    *list.List // front is most recently used
+	entries  map[cid.Cid]*list.Element
+
+	// misses tracks keys being read from the underlying store after a cache
+	// miss, so that a DeleteBlock racing with the read can keep the deleted
+	// block from being put back in the cache.
+	misses map[cid.Cid]*pendingMiss
+}
+
+// pendingMiss is shared by all concurrent cache misses for the same key.
+type pendingMiss struct {
+	refs    int
+	deleted bool
+}
+
+var _ Blockstore = (*LRUCacheStore)(nil)
+var _ Viewer = (*LRUCacheStore)(nil)
+
+// NewLRUCacheStore wraps bs with an in-memory cache holding at most maxBytes
+// of block data. Blocks larger than maxBytes are never cached.
+func NewLRUCacheStore(bs Blockstore, maxBytes uint64) *LRUCacheStore {
+	return &LRUCacheStore{
+		bs:       bs,
+		maxBytes: maxBytes,
+		order:    list.New(),
+		entries:  make(map[cid.Cid]*list.Element),
+		misses:   make(map[cid.Cid]*pendingMiss),
+	}
+}
+
+func (c *LRUCacheStore) get(k cid.Cid) (blocks.Block, bool) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+
+	return c.getLocked(k)
+}
+
+func (c *LRUCacheStore) getLocked(k cid.Cid) (blocks.Block, bool) {
+	e, ok := c.entries[k]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(blocks.Block), true
+}
+
+// beginMiss is get, but on a miss it registers a pending miss for k, which
+// must be ended with endMiss once the underlying store has been read.
+func (c *LRUCacheStore) beginMiss(k cid.Cid) (blocks.Block, *pendingMiss) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+
+	if b, ok := c.getLocked(k); ok {
+		return b, nil
+	}
+
+	pm, ok := c.misses[k]
+	if !ok {
+		pm = new(pendingMiss)
+		c.misses[k] = pm
+	}
+	pm.refs++
+	return nil, pm
+}
+
+// endMiss ends a pending miss for k and caches b, if it was read and k wasn't
+// deleted in the meantime.
+func (c *LRUCacheStore) endMiss(k cid.Cid, pm *pendingMiss, b blocks.Block) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+
+	pm.refs--
+	if pm.refs == 0 {
+		delete(c.misses, k)
+	}
+
+	if b == nil || pm.deleted {
+		return
+	}
+
+	size := uint64(len(b.RawData()))
+	if size > c.maxBytes {
+		return
+	}
+
+	if e, ok := c.entries[k]; ok {
+		c.order.MoveToFront(e)
+		return
+	}
+
+	c.entries[k] = c.order.PushFront(b)
+	c.curBytes += size
+
+	for c.curBytes > c.maxBytes {
+		c.removeElement(c.order.Back())
+	}
+}
+
+func (c *LRUCacheStore) evict(k cid.Cid) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+
+	if pm, ok := c.misses[k]; ok {
+		pm.deleted = true
+	}
+	if e, ok := c.entries[k]; ok {
+		c.removeElement(e)
+	}
+}
+
+// removeElement must be called with the lock held.
+func (c *LRUCacheStore) removeElement(e *list.Element) {
+	b := c.order.Remove(e).(blocks.Block)
+	delete(c.entries, b.Cid())
+	c.curBytes -= uint64(len(b.RawData()))
+}
+
+func (c *LRUCacheStore) Get(k cid.Cid) (blocks.Block, error) {
+	b, pm := c.beginMiss(k)
+	if pm == nil {
+		return b, nil
+	}
+
+	b, err := c.bs.Get(k)
+	if err != nil {
+		c.endMiss(k, pm, nil)
+		return nil, err
+	}
+	c.endMiss(k, pm, b)
+	return b, nil
+}
+
+func (c *LRUCacheStore) View(k cid.Cid, callback func([]byte) error) error {
+	b, err := c.Get(k)
+	if err != nil {
+		return err
+	}
+	return callback(b.RawData())
+}
+
+func (c *LRUCacheStore) GetSize(k cid.Cid) (int, error) {
+	if b, ok := c.get(k); ok {
+		return len(b.RawData()), nil
+	}
+	return c.bs.GetSize(k)
+}
+
+func (c *LRUCacheStore) Has(k cid.Cid) (bool, error) {
+	if _, ok := c.get(k); ok {
+		return true, nil
+	}
+	return c.bs.Has(k)
+}
+
+func (c *LRUCacheStore) Put(b blocks.Block) error {
+	return c.bs.Put(b)
+}
+
+func (c *LRUCacheStore) PutMany(bs []blocks.Block) error {
+	return c.bs.PutMany(bs)
+}
+
+func (c *LRUCacheStore) DeleteBlock(k cid.Cid) error {
+	err := c.bs.DeleteBlock(k)
+	c.evict(k)
+	return err
+}
+
+func (c *LRUCacheStore) AllKeysChan(ctx context.Context) (<-chan cid.Cid, error) {
+	return c.bs.AllKeysChan(ctx)
+}
+
+func (c *LRUCacheStore) HashOnRead(enabled bool) {
+	c.bs.HashOnRead(enabled)
+}
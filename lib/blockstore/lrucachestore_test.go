@@ -0,0 +1,123 @@
+package blockstore
+
+import (
+	"testing"
+
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUCacheStoreEviction(t *testing.T) {
+	mem := NewTemporary()
+	cache := NewLRUCacheStore(mem, 10)
+
+	b1 := blocks.NewBlock([]byte("aaaa"))
+	b2 := blocks.NewBlock([]byte("bbbb"))
+	b3 := blocks.NewBlock([]byte("cccc"))
+	big := blocks.NewBlock([]byte("way more than ten bytes"))
+	for _, b := range []blocks.Block{b1, b2, b3, big} {
+		require.NoError(t, cache.Put(b))
+	}
+
+	cached := func(b blocks.Block) bool {
+		_, ok := cache.entries[b.Cid()]
+		return ok
+	}
+
+	// puts don't populate the cache, reads do
+	require.False(t, cached(b1))
+	_, err := cache.Get(b1.Cid())
+	require.NoError(t, err)
+	_, err = cache.Get(b2.Cid())
+	require.NoError(t, err)
+	require.True(t, cached(b1))
+	require.True(t, cached(b2))
+
+	// refresh b1, then read b3, which goes over 10 bytes and evicts the
+	// least recently used block, b2
+	_, err = cache.Get(b1.Cid())
+	require.NoError(t, err)
+	_, err = cache.Get(b3.Cid())
+	require.NoError(t, err)
+	require.True(t, cached(b1))
+	require.False(t, cached(b2))
+	require.True(t, cached(b3))
+	require.Equal(t, uint64(8), cache.curBytes)
+
+	// blocks larger than the cache are served but never cached
+	b, err := cache.Get(big.Cid())
+	require.NoError(t, err)
+	require.Equal(t, big.RawData(), b.RawData())
+	require.False(t, cached(big))
+
+	// deletes go through to the underlying store and evict
+	require.NoError(t, cache.DeleteBlock(b1.Cid()))
+	require.False(t, cached(b1))
+	has, err := cache.Has(b1.Cid())
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+// racyStore runs onGet after reading a block but before returning it,
+// simulating a delete that lands while a cache miss is being served.
+type racyStore struct {
+	MemStore
+	onGet func(cid.Cid)
+}
+
+func (r *racyStore) Get(k cid.Cid) (blocks.Block, error) {
+	b, err := r.MemStore.Get(k)
+	if r.onGet != nil {
+		r.onGet(k)
+	}
+	return b, err
+}
+
+func TestLRUCacheStoreDeleteDuringGet(t *testing.T) {
+	bs := &racyStore{MemStore: NewTemporary()}
+	cache := NewLRUCacheStore(bs, 1<<10)
+
+	b1 := blocks.NewBlock([]byte("some data"))
+	require.NoError(t, cache.Put(b1))
+
+	bs.onGet = func(k cid.Cid) {
+		bs.onGet = nil
+		require.NoError(t, cache.DeleteBlock(k))
+	}
+
+	// the read itself still returns the block it got from the store...
+	b, err := cache.Get(b1.Cid())
+	require.NoError(t, err)
+	require.Equal(t, b1.RawData(), b.RawData())
+
+	// ...but it must not be cached, since it was deleted in the meantime
+	has, err := cache.Has(b1.Cid())
+	require.NoError(t, err)
+	require.False(t, has)
+	_, err = cache.Get(b1.Cid())
+	require.Equal(t, ErrNotFound, err)
+	require.Empty(t, cache.misses)
+}
+
+func TestLRUCacheStoreDeleteOtherKeyDuringGet(t *testing.T) {
+	bs := &racyStore{MemStore: NewTemporary()}
+	cache := NewLRUCacheStore(bs, 1<<10)
+
+	b1 := blocks.NewBlock([]byte("some data"))
+	b2 := blocks.NewBlock([]byte("other data"))
+	require.NoError(t, cache.Put(b1))
+	require.NoError(t, cache.Put(b2))
+
+	bs.onGet = func(cid.Cid) {
+		bs.onGet = nil
+		require.NoError(t, cache.DeleteBlock(b2.Cid()))
+	}
+
+	// deleting an unrelated block doesn't stop b1 from being cached
+	_, err := cache.Get(b1.Cid())
+	require.NoError(t, err)
+	_, ok := cache.entries[b1.Cid()]
+	require.True(t, ok)
+	require.Empty(t, cache.misses)
+}
@@ -0,0 +1,13 @@
+package blockstore
+
+import (
+	"go.opencensus.io/stats"
+)
+
+// Measures for FallbackStore. Their views are registered in the metrics
+// package, which lib/blockstore cannot import.
+var (
+	FallbackStoreFallbackHit      = stats.Int64("blockstore/fallback/fallback_hit", "Counter for blocks fetched through a fallback", stats.UnitDimensionless)
+	FallbackStoreFallbackMiss     = stats.Int64("blockstore/fallback/fallback_miss", "Counter for blocks no fallback could fetch", stats.UnitDimensionless)
+	FallbackStoreFallbackDuration = stats.Float64("blockstore/fallback/fallback_ms", "Duration of fallback fetches in ms", stats.UnitMilliseconds)
+)
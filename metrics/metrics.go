@@ -9,6 +9,8 @@ import (
 	"go.opencensus.io/tag"
 
 	rpcmetrics "github.com/filecoin-project/go-jsonrpc/metrics"
+
+	"github.com/filecoin-project/lotus/lib/blockstore"
 )
 
 // Distribution
@@ -176,6 +178,18 @@ var (
 		Measure:     VMFlushCopyCount,
 		Aggregation: view.Sum(),
 	}
+	FallbackStoreFallbackHitView = &view.View{
+		Measure:     blockstore.FallbackStoreFallbackHit,
+		Aggregation: view.Count(),
+	}
+	FallbackStoreFallbackMissView = &view.View{
+		Measure:     blockstore.FallbackStoreFallbackMiss,
+		Aggregation: view.Count(),
+	}
+	FallbackStoreFallbackDurationView = &view.View{
+		Measure:     blockstore.FallbackStoreFallbackDuration,
+		Aggregation: defaultMillisecondsDistribution,
+	}
 )
 
 // DefaultViews is an array of OpenCensus views for metric gathering purposes
@@ -204,6 +218,9 @@ var DefaultViews = append([]*view.View{
 	APIRequestDurationView,
 	VMFlushCopyCountView,
 	VMFlushCopyDurationView,
+	FallbackStoreFallbackHitView,
+	FallbackStoreFallbackMissView,
+	FallbackStoreFallbackDurationView,
 },
 	rpcmetrics.DefaultViews...)
 
@@ -485,6 +485,10 @@ func ConfigFullNode(c interface{}) Option {
 		),
 		Override(new(dtypes.Graphsync), modules.Graphsync(cfg.Client.SimultaneousTransfers)),
 
+		If(cfg.Chainstore.BlockCacheSize > 0,
+			Override(new(dtypes.ChainRawBlockstore), modules.LRUCachedChainRawBlockstore(cfg.Chainstore.BlockCacheSize)),
+		),
+
 		If(cfg.Metrics.HeadNotifs,
 			Override(HeadMetricsKey, metrics.SendHeadNotifs(cfg.Metrics.Nickname)),
 		),
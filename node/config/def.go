@@ -20,10 +20,11 @@ type Common struct {
 // FullNode is a full node config
 type FullNode struct {
 	Common
-	Client  Client
-	Metrics Metrics
-	Wallet  Wallet
-	Fees    FeeConfig
+	Client     Client
+	Metrics    Metrics
+	Wallet     Wallet
+	Fees       FeeConfig
+	Chainstore Chainstore
 }
 
 // // Common
@@ -131,6 +132,11 @@ type FeeConfig struct {
 	DefaultMaxFee types.FIL
 }
 
+type Chainstore struct {
+	// in-memory cache of recently read chain blocks, in bytes; 0 = disabled
+	BlockCacheSize uint64
+}
+
 func defCommon() Common {
 	return Common{
 		API: API{
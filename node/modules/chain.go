@@ -91,6 +91,19 @@ func ChainRawBlockstore(lc fx.Lifecycle, mctx helpers.MetricsCtx, r repo.LockedR
 	return cbs, nil
 }
 
+// LRUCachedChainRawBlockstore is ChainRawBlockstore with an additional
+// in-memory cache of up to cacheSize bytes of recently read blocks.
+func LRUCachedChainRawBlockstore(cacheSize uint64) func(lc fx.Lifecycle, mctx helpers.MetricsCtx, r repo.LockedRepo) (dtypes.ChainRawBlockstore, error) {
+	return func(lc fx.Lifecycle, mctx helpers.MetricsCtx, r repo.LockedRepo) (dtypes.ChainRawBlockstore, error) {
+		bs, err := ChainRawBlockstore(lc, mctx, r)
+		if err != nil {
+			return nil, err
+		}
+
+		return blockstore.NewLRUCacheStore(bs, cacheSize), nil
+	}
+}
+
 func ChainBlockService(bs dtypes.ChainRawBlockstore, rem dtypes.ChainBitswap) dtypes.ChainBlockService {
 	return blockservice.New(bs, rem)
 }